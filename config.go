@@ -0,0 +1,88 @@
+package main
+
+import (
+	"log"
+	"strings"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+type configuration struct {
+	APIURLs                  []string                 `json:"api_urls"`
+	AddUUIDParameter         bool                     `json:"add_uuid_parameter"`
+	SearchLatitude           float64                  `json:"search_latitude"`
+	SearchLongitude          float64                  `json:"search_longitude"`
+	SearchPolyline           [][]float64              `json:"search_polyline"`
+	NumNearestLocationsToLog int                      `json:"num_nearest_locations_to_log"`
+	FilterProvider           string                   `json:"filter_provider"`
+	FilterDistanceMiles      float64                  `json:"filter_distance_miles"`
+	MaxConcurrentRequests    int                      `json:"max_concurrent_requests"`
+	RequestTimeout           string                   `json:"request_timeout"`
+	RunMode                  string                   `json:"run_mode"`
+	PollCron                 string                   `json:"poll_cron"`
+	NotificationSinks        []notificationSinkConfig `json:"notification_sinks"`
+	HTTPListenAddress        string                   `json:"http_listen_address"`
+	GRPCListenAddress        string                   `json:"grpc_listen_address"`
+	CacheTTL                 string                   `json:"cache_ttl"`
+}
+
+const (
+	runModeOneshot = "oneshot"
+	runModeDaemon  = "daemon"
+	runModeServe   = "serve"
+)
+
+// envPrefix is the prefix viper uses to recognize environment variable overrides, e.g.
+// FINDVAX_SEARCH_LATITUDE overrides the search_latitude config key.
+const envPrefix = "FINDVAX"
+
+// RegisterConfigFlags defines one pflag per overridable scalar configuration key, named
+// after its json tag (e.g. --search_latitude), so that main can parse them before
+// calling ReadConfiguration. Flags default to their zero value and are only applied as
+// overrides when explicitly passed on the command line.
+func RegisterConfigFlags(flags *pflag.FlagSet) {
+	flags.Float64("search_latitude", 0, "override search_latitude")
+	flags.Float64("search_longitude", 0, "override search_longitude")
+	flags.String("filter_provider", "", "override filter_provider")
+	flags.Float64("filter_distance_miles", 0, "override filter_distance_miles")
+	flags.Int("max_concurrent_requests", 0, "override max_concurrent_requests")
+	flags.String("request_timeout", "", "override request_timeout")
+	flags.String("run_mode", "", "override run_mode")
+	flags.String("poll_cron", "", "override poll_cron")
+	flags.String("http_listen_address", "", "override http_listen_address")
+	flags.String("grpc_listen_address", "", "override grpc_listen_address")
+	flags.String("cache_ttl", "", "override cache_ttl")
+}
+
+// ReadConfiguration loads configuration from configFile (JSON or YAML, detected by
+// extension) using viper, so that any key can also be overridden by an environment
+// variable named envPrefix + "_" + the uppercased json key (e.g. FINDVAX_SEARCH_LATITUDE)
+// or by the matching flag registered by RegisterConfigFlags (e.g. --search_latitude).
+func ReadConfiguration(configFile string, flags *pflag.FlagSet) (*configuration, error) {
+	log.Printf("reading config file %q", configFile)
+
+	v := viper.New()
+	v.SetConfigFile(configFile)
+	v.SetEnvPrefix(envPrefix)
+	v.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
+	v.AutomaticEnv()
+
+	if err := v.ReadInConfig(); err != nil {
+		return nil, err
+	}
+
+	if err := v.BindPFlags(flags); err != nil {
+		return nil, err
+	}
+
+	var config configuration
+	if err := v.Unmarshal(&config, func(decoderConfig *mapstructure.DecoderConfig) {
+		decoderConfig.TagName = "json"
+	}); err != nil {
+		return nil, err
+	}
+
+	return &config, nil
+}
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"context"
+	"log"
+	"sync"
+
+	"github.com/robfig/cron/v3"
+)
+
+// runOneshot runs a single search and exits, matching the tool's original behavior.
+func runOneshot(configuration *configuration) {
+	if _, err := Search(context.Background(), configuration); err != nil {
+		log.Fatalf("Search error %v", err)
+	}
+}
+
+// runDaemon keeps the process running, re-running Search on the schedule
+// described by configuration.PollCron and notifying configuration.NotificationSinks
+// whenever a location transitions to having appointments available or its appointment
+// slots grow, rather than alerting on every unchanged poll.
+func runDaemon(configuration *configuration) {
+	sinks := buildNotificationSinks(configuration.NotificationSinks)
+	log.Printf("runDaemon starting with %v notification sinks, poll_cron = %q", len(sinks), configuration.PollCron)
+
+	tracker := newLocationStateTracker()
+
+	cronScheduler := cron.New()
+	_, err := cronScheduler.AddFunc(configuration.PollCron, func() {
+		runDaemonPoll(configuration, tracker, sinks)
+	})
+	if err != nil {
+		log.Fatalf("invalid poll_cron %q: %v", configuration.PollCron, err)
+	}
+
+	cronScheduler.Start()
+
+	select {}
+}
+
+func runDaemonPoll(configuration *configuration, tracker *locationStateTracker, sinks []notificationSink) {
+	locations, err := Search(context.Background(), configuration)
+	if err != nil {
+		log.Printf("Search error %v", err)
+		return
+	}
+
+	changes := tracker.update(locations)
+	log.Printf("runDaemonPoll found %v changed locations", len(changes))
+
+	for _, change := range changes {
+		for _, sink := range sinks {
+			if err := sink.notify(change); err != nil {
+				log.Printf("notification sink error %v", err)
+			}
+		}
+	}
+}
+
+// locationState is the subset of a location's appointment status that is remembered
+// across polls. AppointmentsAvailable and SlotCount decide whether to notify;
+// LastModified is recorded and reported but not compared.
+type locationState struct {
+	LastModified          string `json:"last_modified"`
+	AppointmentsAvailable bool   `json:"appointments_available"`
+	SlotCount             int    `json:"slot_count"`
+}
+
+// locationChange describes a location whose locationState improved between two polls.
+type locationChange struct {
+	feature       *vaccineLocationFeature
+	previousState locationState
+	currentState  locationState
+}
+
+// locationStateTracker remembers the last-seen locationState for each location, keyed by
+// Provider + "/" + ProviderLocationID, across daemon poll cycles.
+type locationStateTracker struct {
+	mutex  sync.Mutex
+	states map[string]locationState
+}
+
+func newLocationStateTracker() *locationStateTracker {
+	return &locationStateTracker{
+		states: make(map[string]locationState),
+	}
+}
+
+func locationStateKey(properties vaccineLocationProperties) string {
+	return properties.Provider + "/" + properties.ProviderLocationID
+}
+
+// update records the current state of each location and returns the ones that newly
+// have appointments available, or whose appointment slot count grew, since the last
+// call to update. Locations seen for the first time are recorded but never reported,
+// since there is no prior state to compare against.
+func (tracker *locationStateTracker) update(locations []vaccineLocationFeatureAndDistance) []locationChange {
+	tracker.mutex.Lock()
+	defer tracker.mutex.Unlock()
+
+	var changes []locationChange
+	seenKeys := make(map[string]bool, len(locations))
+
+	for _, location := range locations {
+		properties := location.vaccineLocationFeature.Properties
+		key := locationStateKey(properties)
+		seenKeys[key] = true
+
+		currentState := locationState{
+			LastModified:          properties.AppointmentsLastModified,
+			AppointmentsAvailable: properties.AppointmentsAvailable,
+			SlotCount:             len(properties.Appointments),
+		}
+
+		previousState, seenBefore := tracker.states[key]
+		tracker.states[key] = currentState
+
+		if !seenBefore {
+			continue
+		}
+
+		newlyAvailable := currentState.AppointmentsAvailable && !previousState.AppointmentsAvailable
+		slotsGrew := currentState.SlotCount > previousState.SlotCount
+
+		if newlyAvailable || slotsGrew {
+			changes = append(changes, locationChange{
+				feature:       location.vaccineLocationFeature,
+				previousState: previousState,
+				currentState:  currentState,
+			})
+		}
+	}
+
+	// locations is already filtered down to those with appointments, so a location that
+	// drops out of it (no appointments this poll) never hits the loop above. Without
+	// resetting its stored state here, a later re-appearance would be compared against
+	// its stale last-available state and the newlyAvailable transition would be missed.
+	for key, state := range tracker.states {
+		if seenKeys[key] || (!state.AppointmentsAvailable && state.SlotCount == 0) {
+			continue
+		}
+
+		state.AppointmentsAvailable = false
+		state.SlotCount = 0
+		tracker.states[key] = state
+	}
+
+	return changes
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"context"
+
+	pb "github.com/aaronriekenberg/go-find-vaccine/proto"
+)
+
+// vaccineFinderServer implements pb.VaccineFinderServer (generated from
+// proto/vaccinefinder.proto via "make proto") on top of searchService, so the gRPC API
+// shares its query handling and result cache with the HTTP JSON API.
+type vaccineFinderServer struct {
+	pb.UnimplementedVaccineFinderServer
+
+	service *searchService
+}
+
+func (server *vaccineFinderServer) Search(ctx context.Context, request *pb.SearchRequest) (*pb.SearchResponse, error) {
+	locations, err := server.service.search(ctx, searchQuery{
+		latitude:       request.GetLatitude(),
+		longitude:      request.GetLongitude(),
+		radiusMiles:    request.GetRadiusMiles(),
+		filterProvider: request.GetProvider(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.SearchResponse{
+		Locations: make([]*pb.Location, 0, len(locations)),
+	}
+
+	for _, location := range locations {
+		properties := location.vaccineLocationFeature.Properties
+
+		response.Locations = append(response.Locations, &pb.Location{
+			Provider:              properties.Provider,
+			ProviderLocationId:    properties.ProviderLocationID,
+			Name:                  properties.Name,
+			Latitude:              location.vaccineLocationFeature.Geometry.Coordinates[1],
+			Longitude:             location.vaccineLocationFeature.Geometry.Coordinates[0],
+			DistanceMiles:         location.distanceMiles,
+			ClosestSegmentIndex:   int32(location.closestSegmentIndex),
+			AppointmentsAvailable: properties.AppointmentsAvailable,
+		})
+	}
+
+	return response, nil
+}
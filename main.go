@@ -1,28 +1,33 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"log"
+	"math"
 	"net/http"
 	"os"
 	"sort"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/kr/pretty"
+	"github.com/spf13/pflag"
 	"github.com/umahmood/haversine"
 )
 
-type configuration struct {
-	APIURLs                  []string `json:"api_urls"`
-	AddUUIDParameter         bool     `json:"add_uuid_parameter"`
-	SearchLatitude           float64  `json:"search_latitude"`
-	SearchLongitude          float64  `json:"search_longitude"`
-	NumNearestLocationsToLog int      `json:"num_nearest_locations_to_log"`
-	FilterProvider           string   `json:"filter_provider"`
-	FilterDistanceMiles      float64  `json:"filter_distance_miles"`
+const defaultRequestTimeout = 30 * time.Second
+
+var sharedHTTPClient = &http.Client{
+	Transport: &http.Transport{
+		MaxIdleConnsPerHost:   20,
+		IdleConnTimeout:       90 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+	},
 }
 
 type geometry struct {
@@ -65,20 +70,103 @@ type apiGETResponse struct {
 type vaccineLocationFeatureAndDistance struct {
 	vaccineLocationFeature *vaccineLocationFeature
 	distanceMiles          float64
+	closestSegmentIndex    int
+}
+
+// distanceFromLineString returns the perpendicular distance in miles from point to the
+// nearest segment of line, along with the index of that segment (the segment between
+// line[closestSegment] and line[closestSegment+1]). It is used to filter and sort
+// locations along an ordered polyline (e.g. a commute route) instead of a single point.
+func distanceFromLineString(point haversine.Coord, line []haversine.Coord) (miles float64, closestSegment int) {
+	miles = math.Inf(1)
+	closestSegment = -1
+
+	for i := 0; i < len(line)-1; i = i + 1 {
+		projectedPoint := projectPointOntoSegment(point, line[i], line[i+1])
+
+		segmentDistanceMiles, _ := haversine.Distance(point, projectedPoint)
+		if segmentDistanceMiles < miles {
+			miles = segmentDistanceMiles
+			closestSegment = i
+		}
+	}
+
+	return miles, closestSegment
+}
+
+// projectPointOntoSegment projects point onto the line segment a->b using a local
+// equirectangular approximation (longitude scaled by cos of the segment's mid-latitude
+// so that degrees of latitude and longitude are comparable), clamping the projection to
+// the segment itself, and returns the projected point as a haversine.Coord.
+func projectPointOntoSegment(point, a, b haversine.Coord) haversine.Coord {
+	lonScale := math.Cos(degreesToRadians((a.Lat + b.Lat) / 2))
+
+	ax, ay := a.Lon*lonScale, a.Lat
+	bx, by := b.Lon*lonScale, b.Lat
+	px, py := point.Lon*lonScale, point.Lat
+
+	abx, aby := bx-ax, by-ay
+	apx, apy := px-ax, py-ay
+
+	t := 0.0
+	if abLengthSquared := abx*abx + aby*aby; abLengthSquared > 0 {
+		t = (apx*abx + apy*aby) / abLengthSquared
+		if t < 0 {
+			t = 0
+		} else if t > 1 {
+			t = 1
+		}
+	}
+
+	return haversine.Coord{
+		Lat: ay + t*aby,
+		Lon: (ax + t*abx) / lonScale,
+	}
+}
+
+func degreesToRadians(degrees float64) float64 {
+	return degrees * math.Pi / 180
 }
 
-func makeHTTPGETCallWithResponse(url string, expectedStatusCode int) ([]byte, error) {
+// searchPolylineFromConfiguration converts configuration.SearchPolyline ([][lat, lon])
+// into haversine.Coords, or returns nil if fewer than 2 points are configured so callers
+// can fall back to the single SearchLatitude/SearchLongitude point.
+func searchPolylineFromConfiguration(configuration *configuration) []haversine.Coord {
+	if len(configuration.SearchPolyline) < 2 {
+		return nil
+	}
+
+	searchPolyline := make([]haversine.Coord, 0, len(configuration.SearchPolyline))
+	for _, point := range configuration.SearchPolyline {
+		if len(point) != 2 {
+			log.Printf("search_polyline point has unknown length %v", len(point))
+			continue
+		}
+		searchPolyline = append(searchPolyline, haversine.Coord{
+			Lat: point[0],
+			Lon: point[1],
+		})
+	}
+
+	if len(searchPolyline) < 2 {
+		return nil
+	}
+
+	return searchPolyline
+}
+
+func makeHTTPGETCallWithResponse(ctx context.Context, url string, expectedStatusCode int) ([]byte, error) {
 	const method = "GET"
 
 	log.Printf("makeHTTPGETCallWithResponse url = %q", url)
 
-	request, err := http.NewRequest(method, url, nil)
+	request, err := http.NewRequestWithContext(ctx, method, url, nil)
 	if err != nil {
-		log.Printf("NewRequest error %v", err)
+		log.Printf("NewRequestWithContext error %v", err)
 		return nil, err
 	}
 
-	response, err := http.DefaultClient.Do(request)
+	response, err := sharedHTTPClient.Do(request)
 	if err != nil {
 		log.Printf("error calling url %q method %v %v", url, method, err.Error())
 		return nil, err
@@ -104,10 +192,10 @@ func makeHTTPGETCallWithResponse(url string, expectedStatusCode int) ([]byte, er
 	return responseBodyBytes, nil
 }
 
-func makeAPIGETCall(url string) (*apiGETResponse, error) {
+func makeAPIGETCall(ctx context.Context, url string) (*apiGETResponse, error) {
 	log.Printf("makeAPIGETCall url = %q", url)
 
-	responseBodyBytes, err := makeHTTPGETCallWithResponse(url, 200)
+	responseBodyBytes, err := makeHTTPGETCallWithResponse(ctx, url, 200)
 	if err != nil {
 		log.Printf("makeHTTPGETCallWithResponse error %v", err.Error())
 		return nil, err
@@ -123,24 +211,14 @@ func makeAPIGETCall(url string) (*apiGETResponse, error) {
 	return &apiGETResponse, nil
 }
 
-func ReadConfiguration(configFile string) (*configuration, error) {
-	log.Printf("reading config file %q", configFile)
-
-	source, err := ioutil.ReadFile(configFile)
-	if err != nil {
-		return nil, err
-	}
-
-	var config configuration
-	if err = json.Unmarshal(source, &config); err != nil {
-		return nil, err
-	}
-
-	return &config, nil
-}
-
-func searchForAppointments(configuration *configuration) {
-	log.Printf("begin searchForAppointments")
+// Search is the pure search core: given a configuration it fetches all configured API
+// URLs, filters and sorts the resulting locations, and returns them. It has no side
+// effects other than logging, so it is shared by the CLI oneshot/daemon run modes and
+// the HTTP/gRPC serve run mode. ctx bounds the whole search; it is the parent of each
+// per-request timeout, so canceling it (e.g. an HTTP client disconnecting) stops
+// in-flight upstream fetches instead of letting them run to completion.
+func Search(ctx context.Context, configuration *configuration) ([]vaccineLocationFeatureAndDistance, error) {
+	log.Printf("begin Search")
 
 	searchLocation := haversine.Coord{
 		Lat: configuration.SearchLatitude,
@@ -148,21 +226,55 @@ func searchForAppointments(configuration *configuration) {
 	}
 	log.Printf("searchLocation:\n%# v", pretty.Formatter(searchLocation))
 
+	searchPolyline := searchPolylineFromConfiguration(configuration)
+	log.Printf("len(searchPolyline) = %v", len(searchPolyline))
+
+	requestTimeout, err := time.ParseDuration(configuration.RequestTimeout)
+	if err != nil {
+		log.Printf("invalid or missing request_timeout %q, using default %v", configuration.RequestTimeout, defaultRequestTimeout)
+		requestTimeout = defaultRequestTimeout
+	}
+
+	maxConcurrentRequests := configuration.MaxConcurrentRequests
+	if maxConcurrentRequests <= 0 {
+		maxConcurrentRequests = 1
+	}
+	requestSemaphore := make(chan struct{}, maxConcurrentRequests)
+
+	var apiResponsesMutex sync.Mutex
 	var apiResponses []*apiGETResponse
 
+	var waitGroup sync.WaitGroup
 	for _, url := range configuration.APIURLs {
+		url := url
 		if configuration.AddUUIDParameter {
 			url = url + "?q=" + uuid.New().String()
 		}
-		apiResponse, err := makeAPIGETCall(url)
-		if err != nil {
-			log.Fatalf("makeAPIGETCall error %v", err)
-		}
 
-		log.Printf("got %v features in api response from %q", len(apiResponse.Features), url)
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+
+			requestSemaphore <- struct{}{}
+			defer func() { <-requestSemaphore }()
 
-		apiResponses = append(apiResponses, apiResponse)
+			requestCtx, cancel := context.WithTimeout(ctx, requestTimeout)
+			defer cancel()
+
+			apiResponse, err := makeAPIGETCall(requestCtx, url)
+			if err != nil {
+				log.Printf("makeAPIGETCall error %v, skipping url %q", err, url)
+				return
+			}
+
+			log.Printf("got %v features in api response from %q", len(apiResponse.Features), url)
+
+			apiResponsesMutex.Lock()
+			apiResponses = append(apiResponses, apiResponse)
+			apiResponsesMutex.Unlock()
+		}()
 	}
+	waitGroup.Wait()
 
 	filterProvider := strings.ToLower(configuration.FilterProvider)
 	log.Printf("filterProvider = %q", filterProvider)
@@ -197,7 +309,13 @@ func searchForAppointments(configuration *configuration) {
 			}
 			// log.Printf("featureLocation:\n%# v", pretty.Formatter(featureLocation))
 
-			currentFeatureDistanceMiles, _ := haversine.Distance(searchLocation, featureLocation)
+			var currentFeatureDistanceMiles float64
+			closestSegmentIndex := -1
+			if searchPolyline != nil {
+				currentFeatureDistanceMiles, closestSegmentIndex = distanceFromLineString(featureLocation, searchPolyline)
+			} else {
+				currentFeatureDistanceMiles, _ = haversine.Distance(searchLocation, featureLocation)
+			}
 
 			// log.Printf("currentFeatureDistanceMiles = %v", currentFeatureDistanceMiles)
 
@@ -208,6 +326,7 @@ func searchForAppointments(configuration *configuration) {
 			vaccineLocationFeatureAndDistance := vaccineLocationFeatureAndDistance{
 				vaccineLocationFeature: currentFeature,
 				distanceMiles:          currentFeatureDistanceMiles,
+				closestSegmentIndex:    closestSegmentIndex,
 			}
 
 			locationsWithAppointmentsPassingFilters = append(locationsWithAppointmentsPassingFilters, vaccineLocationFeatureAndDistance)
@@ -217,7 +336,14 @@ func searchForAppointments(configuration *configuration) {
 	log.Printf("len(locationsWithAppointmentsPassingFilters) = %v", len(locationsWithAppointmentsPassingFilters))
 
 	sort.Slice(locationsWithAppointmentsPassingFilters, func(i, j int) bool {
-		return locationsWithAppointmentsPassingFilters[i].distanceMiles < locationsWithAppointmentsPassingFilters[j].distanceMiles
+		left := locationsWithAppointmentsPassingFilters[i]
+		right := locationsWithAppointmentsPassingFilters[j]
+
+		if left.closestSegmentIndex != right.closestSegmentIndex {
+			return left.closestSegmentIndex < right.closestSegmentIndex
+		}
+
+		return left.distanceMiles < right.distanceMiles
 	})
 
 	log.Printf("nearest %v features with appointments passing filters:", configuration.NumNearestLocationsToLog)
@@ -225,22 +351,36 @@ func searchForAppointments(configuration *configuration) {
 	for i := 0; (i < configuration.NumNearestLocationsToLog) && (i < len(locationsWithAppointmentsPassingFilters)); i = i + 1 {
 		log.Printf("\navailable location:\n%# v", pretty.Formatter(locationsWithAppointmentsPassingFilters[i]))
 	}
-	log.Printf("end searchForAppointments")
+	log.Printf("end Search")
+
+	return locationsWithAppointmentsPassingFilters, nil
 }
 
 func main() {
 	log.SetFlags(log.Ldate | log.Ltime | log.Lmicroseconds)
 
-	if len(os.Args) != 2 {
-		log.Fatalf("usage %v <config file>", os.Args[0])
+	RegisterConfigFlags(pflag.CommandLine)
+	pflag.Parse()
+
+	if pflag.NArg() != 1 {
+		log.Fatalf("usage %v [flags] <config file>", os.Args[0])
 	}
 
-	configuration, err := ReadConfiguration(os.Args[1])
+	configuration, err := ReadConfiguration(pflag.Arg(0), pflag.CommandLine)
 	if err != nil {
 		log.Fatalf("error reading configuration %v", err)
 	}
 
 	log.Printf("configuration:\n%# v", pretty.Formatter(configuration))
 
-	searchForAppointments(configuration)
+	switch configuration.RunMode {
+	case "", runModeOneshot:
+		runOneshot(configuration)
+	case runModeDaemon:
+		runDaemon(configuration)
+	case runModeServe:
+		runServe(configuration)
+	default:
+		log.Fatalf("unknown run_mode %q", configuration.RunMode)
+	}
 }
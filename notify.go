@@ -0,0 +1,186 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/smtp"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// notificationSinkConfig describes one configured notification sink. Type selects which
+// of Webhook, SMTP, or Exec is populated.
+type notificationSinkConfig struct {
+	Type    string             `json:"type"`
+	Webhook *webhookSinkConfig `json:"webhook,omitempty"`
+	SMTP    *smtpSinkConfig    `json:"smtp,omitempty"`
+	Exec    *execSinkConfig    `json:"exec,omitempty"`
+}
+
+type webhookSinkConfig struct {
+	URL string `json:"url"`
+}
+
+type smtpSinkConfig struct {
+	Host    string   `json:"host"`
+	Port    int      `json:"port"`
+	From    string   `json:"from"`
+	To      []string `json:"to"`
+	Subject string   `json:"subject"`
+}
+
+type execSinkConfig struct {
+	Command string   `json:"command"`
+	Args    []string `json:"args"`
+}
+
+// notificationSink is notified once per locationChange. Implementations return an error
+// rather than panicking; runDaemonPoll logs it and goes on to the remaining sinks, so one
+// broken sink cannot prevent others from running.
+type notificationSink interface {
+	notify(change locationChange) error
+}
+
+func buildNotificationSinks(configs []notificationSinkConfig) []notificationSink {
+	sinks := make([]notificationSink, 0, len(configs))
+
+	for _, config := range configs {
+		switch config.Type {
+		case "webhook":
+			if config.Webhook == nil {
+				log.Printf("notification sink type webhook missing webhook config, skipping")
+				continue
+			}
+			sinks = append(sinks, &webhookNotificationSink{
+				url: config.Webhook.URL,
+			})
+
+		case "smtp":
+			if config.SMTP == nil {
+				log.Printf("notification sink type smtp missing smtp config, skipping")
+				continue
+			}
+			sinks = append(sinks, &smtpNotificationSink{
+				host:    config.SMTP.Host,
+				port:    config.SMTP.Port,
+				from:    config.SMTP.From,
+				to:      config.SMTP.To,
+				subject: config.SMTP.Subject,
+			})
+
+		case "exec":
+			if config.Exec == nil {
+				log.Printf("notification sink type exec missing exec config, skipping")
+				continue
+			}
+			sinks = append(sinks, &execNotificationSink{
+				command: config.Exec.Command,
+				args:    config.Exec.Args,
+			})
+
+		default:
+			log.Printf("unknown notification sink type %q, skipping", config.Type)
+		}
+	}
+
+	return sinks
+}
+
+// notificationPayload is the JSON representation of a locationChange shared by the
+// webhook and exec sinks.
+type notificationPayload struct {
+	Location      *vaccineLocationFeature `json:"location"`
+	PreviousState locationState           `json:"previous_state"`
+	CurrentState  locationState           `json:"current_state"`
+}
+
+func (change locationChange) toPayload() notificationPayload {
+	return notificationPayload{
+		Location:      change.feature,
+		PreviousState: change.previousState,
+		CurrentState:  change.currentState,
+	}
+}
+
+type webhookNotificationSink struct {
+	url string
+}
+
+func (sink *webhookNotificationSink) notify(change locationChange) error {
+	body, err := json.Marshal(change.toPayload())
+	if err != nil {
+		return err
+	}
+
+	request, err := http.NewRequestWithContext(context.Background(), "POST", sink.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := sharedHTTPClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	// Drain the body so sharedHTTPClient's Transport can reuse the connection.
+	defer ioutil.ReadAll(io.LimitReader(response.Body, 4096))
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return fmt.Errorf("webhook %q returned unexpected status code %v", sink.url, response.StatusCode)
+	}
+
+	return nil
+}
+
+type smtpNotificationSink struct {
+	host    string
+	port    int
+	from    string
+	to      []string
+	subject string
+}
+
+func (sink *smtpNotificationSink) notify(change locationChange) error {
+	properties := change.feature.Properties
+
+	body := fmt.Sprintf(
+		"Appointments changed at %v (%v):\nprevious: %+v\ncurrent: %+v\n",
+		properties.Name, properties.ProviderLocationID, change.previousState, change.currentState,
+	)
+
+	message := []byte(fmt.Sprintf(
+		"From: %v\r\nTo: %v\r\nSubject: %v\r\n\r\n%v\r\n",
+		sink.from, strings.Join(sink.to, ", "), sink.subject, body,
+	))
+
+	addr := fmt.Sprintf("%v:%v", sink.host, sink.port)
+
+	return smtp.SendMail(addr, nil, sink.from, sink.to, message)
+}
+
+type execNotificationSink struct {
+	command string
+	args    []string
+}
+
+func (sink *execNotificationSink) notify(change locationChange) error {
+	payload, err := json.Marshal(change.toPayload())
+	if err != nil {
+		return err
+	}
+
+	cmd := exec.Command(sink.command, sink.args...)
+	cmd.Stdin = bytes.NewReader(payload)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	return cmd.Run()
+}
@@ -0,0 +1,296 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+
+	pb "github.com/aaronriekenberg/go-find-vaccine/proto"
+)
+
+const defaultCacheTTL = 60 * time.Second
+
+// runServe starts the long-running HTTP JSON API and gRPC service described in the
+// proto/vaccinefinder.proto service definition, both backed by the same Search function
+// and the same result cache.
+func runServe(configuration *configuration) {
+	cacheTTL, err := time.ParseDuration(configuration.CacheTTL)
+	if err != nil {
+		log.Printf("invalid or missing cache_ttl %q, using default %v", configuration.CacheTTL, defaultCacheTTL)
+		cacheTTL = defaultCacheTTL
+	}
+
+	service := &searchService{
+		baseConfiguration: configuration,
+		cache:             newSearchCache(cacheTTL),
+	}
+
+	go runHTTPServer(configuration.HTTPListenAddress, service)
+
+	runGRPCServer(configuration.GRPCListenAddress, service)
+}
+
+// searchService adapts Search to the HTTP and gRPC handlers, applying per-query
+// overrides on top of baseConfiguration and caching results for cache.ttl. Every query
+// searches from a single lat/lon point, so any search_polyline configured in
+// baseConfiguration is cleared rather than silently taking priority over the query.
+type searchService struct {
+	baseConfiguration *configuration
+	cache             *searchCache
+}
+
+type searchQuery struct {
+	latitude       float64
+	longitude      float64
+	radiusMiles    float64
+	filterProvider string
+}
+
+func (service *searchService) search(ctx context.Context, query searchQuery) ([]vaccineLocationFeatureAndDistance, error) {
+	queryConfiguration := *service.baseConfiguration
+	queryConfiguration.SearchLatitude = query.latitude
+	queryConfiguration.SearchLongitude = query.longitude
+	queryConfiguration.SearchPolyline = nil
+	queryConfiguration.FilterDistanceMiles = query.radiusMiles
+	queryConfiguration.FilterProvider = query.filterProvider
+
+	cacheKey := searchCacheKey(query)
+
+	if locations, found := service.cache.get(cacheKey); found {
+		log.Printf("search cache hit for %+v", query)
+		return locations, nil
+	}
+
+	locations, err := Search(ctx, &queryConfiguration)
+	if err != nil {
+		return nil, err
+	}
+
+	service.cache.set(cacheKey, locations)
+
+	return locations, nil
+}
+
+// locationResponse is the JSON representation of a vaccineLocationFeatureAndDistance
+// returned by the HTTP /appointments endpoint; vaccineLocationFeatureAndDistance itself
+// has unexported fields so it can't be marshaled directly.
+type locationResponse struct {
+	Provider              string  `json:"provider"`
+	ProviderLocationID    string  `json:"provider_location_id"`
+	Name                  string  `json:"name"`
+	Latitude              float64 `json:"latitude"`
+	Longitude             float64 `json:"longitude"`
+	DistanceMiles         float64 `json:"distance_miles"`
+	ClosestSegmentIndex   int     `json:"closest_segment_index"`
+	AppointmentsAvailable bool    `json:"appointments_available"`
+}
+
+func toLocationResponses(locations []vaccineLocationFeatureAndDistance) []locationResponse {
+	responses := make([]locationResponse, 0, len(locations))
+
+	for _, location := range locations {
+		properties := location.vaccineLocationFeature.Properties
+
+		responses = append(responses, locationResponse{
+			Provider:              properties.Provider,
+			ProviderLocationID:    properties.ProviderLocationID,
+			Name:                  properties.Name,
+			Latitude:              location.vaccineLocationFeature.Geometry.Coordinates[1],
+			Longitude:             location.vaccineLocationFeature.Geometry.Coordinates[0],
+			DistanceMiles:         location.distanceMiles,
+			ClosestSegmentIndex:   location.closestSegmentIndex,
+			AppointmentsAvailable: properties.AppointmentsAvailable,
+		})
+	}
+
+	return responses
+}
+
+func searchCacheKey(query searchQuery) string {
+	return fmt.Sprintf("%v|%v|%v|%v", query.latitude, query.longitude, query.radiusMiles, query.filterProvider)
+}
+
+func runHTTPServer(listenAddress string, service *searchService) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/appointments", service.handleAppointments)
+
+	log.Printf("HTTP server listening on %q", listenAddress)
+	if err := http.ListenAndServe(listenAddress, mux); err != nil {
+		log.Fatalf("http.ListenAndServe error %v", err)
+	}
+}
+
+func (service *searchService) handleAppointments(responseWriter http.ResponseWriter, request *http.Request) {
+	query, err := parseSearchQuery(request)
+	if err != nil {
+		http.Error(responseWriter, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	locations, err := service.search(request.Context(), query)
+	if err != nil {
+		http.Error(responseWriter, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	responseWriter.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(responseWriter).Encode(toLocationResponses(locations)); err != nil {
+		log.Printf("error encoding /appointments response %v", err)
+	}
+}
+
+func parseSearchQuery(request *http.Request) (searchQuery, error) {
+	values := request.URL.Query()
+
+	latitude, err := strconv.ParseFloat(values.Get("lat"), 64)
+	if err != nil {
+		return searchQuery{}, fmt.Errorf("invalid or missing lat parameter: %w", err)
+	}
+
+	longitude, err := strconv.ParseFloat(values.Get("lon"), 64)
+	if err != nil {
+		return searchQuery{}, fmt.Errorf("invalid or missing lon parameter: %w", err)
+	}
+
+	var radiusMiles float64
+	if radiusParam := values.Get("radius"); len(radiusParam) > 0 {
+		radiusMiles, err = strconv.ParseFloat(radiusParam, 64)
+		if err != nil {
+			return searchQuery{}, fmt.Errorf("invalid radius parameter: %w", err)
+		}
+	}
+
+	return searchQuery{
+		latitude:       latitude,
+		longitude:      longitude,
+		radiusMiles:    radiusMiles,
+		filterProvider: values.Get("provider"),
+	}, nil
+}
+
+func runGRPCServer(listenAddress string, service *searchService) {
+	listener, err := net.Listen("tcp", listenAddress)
+	if err != nil {
+		log.Fatalf("net.Listen error %v", err)
+	}
+
+	grpcServer := grpc.NewServer()
+	pb.RegisterVaccineFinderServer(grpcServer, &vaccineFinderServer{service: service})
+
+	log.Printf("gRPC server listening on %q", listenAddress)
+	if err := grpcServer.Serve(listener); err != nil {
+		log.Fatalf("grpcServer.Serve error %v", err)
+	}
+}
+
+// maxSearchCacheEntries bounds searchCache.byKey. Its key is built directly from
+// client-supplied lat/lon/radius/provider query parameters (see searchCacheKey), so
+// without a cap a client varying those slightly on every request could grow it forever
+// between sweeps.
+const maxSearchCacheEntries = 10000
+
+// searchCache serves identical queries from memory for ttl, so a burst of requests for
+// the same location doesn't re-hit every upstream vaccine API on each call. A background
+// goroutine sweeps expired entries every ttl, and set evicts the oldest entry if the
+// cache is still full between sweeps, so byKey stays bounded.
+type searchCache struct {
+	ttl   time.Duration
+	mutex sync.Mutex
+	byKey map[string]searchCacheEntry
+}
+
+type searchCacheEntry struct {
+	locations []vaccineLocationFeatureAndDistance
+	expiresAt time.Time
+}
+
+func newSearchCache(ttl time.Duration) *searchCache {
+	cache := &searchCache{
+		ttl:   ttl,
+		byKey: make(map[string]searchCacheEntry),
+	}
+
+	if ttl > 0 {
+		go cache.sweepExpiredPeriodically()
+	}
+
+	return cache
+}
+
+// sweepExpiredPeriodically removes expired entries every ttl for as long as the process
+// runs, so keys that are never looked up again don't linger in byKey indefinitely.
+func (cache *searchCache) sweepExpiredPeriodically() {
+	ticker := time.NewTicker(cache.ttl)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		cache.sweepExpired()
+	}
+}
+
+func (cache *searchCache) sweepExpired() {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	now := time.Now()
+	for key, entry := range cache.byKey {
+		if now.After(entry.expiresAt) {
+			delete(cache.byKey, key)
+		}
+	}
+}
+
+func (cache *searchCache) get(key string) ([]vaccineLocationFeatureAndDistance, bool) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	entry, found := cache.byKey[key]
+	if !found || time.Now().After(entry.expiresAt) {
+		return nil, false
+	}
+
+	return entry.locations, true
+}
+
+func (cache *searchCache) set(key string, locations []vaccineLocationFeatureAndDistance) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	if _, exists := cache.byKey[key]; !exists && len(cache.byKey) >= maxSearchCacheEntries {
+		cache.evictOldestLocked()
+	}
+
+	cache.byKey[key] = searchCacheEntry{
+		locations: locations,
+		expiresAt: time.Now().Add(cache.ttl),
+	}
+}
+
+// evictOldestLocked removes the entry with the earliest expiresAt, bounding cache size
+// between sweeps even if set is called faster than sweepExpiredPeriodically runs.
+// Callers must hold cache.mutex.
+func (cache *searchCache) evictOldestLocked() {
+	var oldestKey string
+	var oldestExpiresAt time.Time
+	found := false
+
+	for key, entry := range cache.byKey {
+		if !found || entry.expiresAt.Before(oldestExpiresAt) {
+			oldestKey = key
+			oldestExpiresAt = entry.expiresAt
+			found = true
+		}
+	}
+
+	if found {
+		delete(cache.byKey, oldestKey)
+	}
+}